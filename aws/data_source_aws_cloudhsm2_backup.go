@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+)
+
+func dataSourceAwsCloudHsm2Backup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudHsm2BackupRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+
+			"backup_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_backup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"never_expires": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudHsm2BackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudhsmv2conn
+
+	input := &cloudhsmv2.DescribeBackupsInput{
+		Filters: map[string][]*string{},
+	}
+
+	if v, ok := d.GetOk("backup_id"); ok {
+		input.Filters["backupIds"] = []*string{aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("cluster_id"); ok {
+		input.Filters["clusterIds"] = []*string{aws.String(v.(string))}
+	}
+
+	out, err := conn.DescribeBackups(input)
+	if err != nil {
+		return fmt.Errorf("error reading CloudHSMv2 Backup: %s", err)
+	}
+
+	requestedTags, hasTags := d.GetOk("tags")
+
+	var matches []*cloudhsmv2.Backup
+	for _, b := range out.Backups {
+		if hasTags {
+			tagList, err := conn.ListTags(&cloudhsmv2.ListTagsInput{
+				ResourceId: b.BackupId,
+			})
+			if err != nil {
+				return fmt.Errorf("error retrieving tags for CloudHSMv2 Backup (%s): %s", aws.StringValue(b.BackupId), err)
+			}
+			if !cloudHsm2TagsMatch(tagList.TagList, requestedTags.(map[string]interface{})) {
+				continue
+			}
+		}
+		matches = append(matches, b)
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("no CloudHSMv2 Backup matched the given criteria")
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("more than one CloudHSMv2 Backup matched the given criteria")
+	}
+
+	backup := matches[0]
+
+	d.SetId(aws.StringValue(backup.BackupId))
+	d.Set("backup_id", backup.BackupId)
+	d.Set("backup_state", backup.BackupState)
+	d.Set("cluster_id", backup.ClusterId)
+	d.Set("source_backup_id", backup.SourceBackup)
+	d.Set("source_region", backup.SourceRegion)
+	d.Set("never_expires", backup.NeverExpires)
+	if backup.CreateTimestamp != nil {
+		d.Set("create_timestamp", backup.CreateTimestamp.Format(time.RFC3339))
+	}
+
+	tagList, err := conn.ListTags(&cloudhsmv2.ListTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error retrieving tags for CloudHSMv2 Backup (%s): %s", d.Id(), err)
+	}
+
+	tags := make(map[string]string, len(tagList.TagList))
+	for _, t := range tagList.TagList {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+)
+
+func dataSourceAwsCloudHsm2Cluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudHsm2ClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchemaComputed(),
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"hsm_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backup_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_certificates": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_csr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"aws_hardware_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hsm_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manufacturer_hardware_certificate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"hsms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hsm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"eni_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"eni_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hsm_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudHsm2ClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudhsmv2conn
+
+	input := &cloudhsmv2.DescribeClustersInput{
+		Filters: map[string][]*string{},
+	}
+
+	if v, ok := d.GetOk("cluster_id"); ok {
+		input.Filters["clusterIds"] = []*string{aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("cluster_state"); ok {
+		input.Filters["states"] = []*string{aws.String(v.(string))}
+	}
+
+	out, err := conn.DescribeClusters(input)
+	if err != nil {
+		return fmt.Errorf("error reading CloudHSMv2 Cluster: %s", err)
+	}
+
+	requestedTags, hasTags := d.GetOk("tags")
+
+	var matches []*cloudhsmv2.Cluster
+	for _, c := range out.Clusters {
+		if hasTags {
+			tagList, err := conn.ListTags(&cloudhsmv2.ListTagsInput{
+				ResourceId: c.ClusterId,
+			})
+			if err != nil {
+				return fmt.Errorf("error retrieving tags for CloudHSMv2 Cluster (%s): %s", aws.StringValue(c.ClusterId), err)
+			}
+			if !cloudHsm2TagsMatch(tagList.TagList, requestedTags.(map[string]interface{})) {
+				continue
+			}
+		}
+		matches = append(matches, c)
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("no CloudHSMv2 Cluster matched the given criteria")
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("more than one CloudHSMv2 Cluster matched the given criteria")
+	}
+
+	cluster := matches[0]
+
+	d.SetId(aws.StringValue(cluster.ClusterId))
+	d.Set("cluster_id", cluster.ClusterId)
+	d.Set("cluster_state", cluster.State)
+	d.Set("vpc_id", cluster.VpcId)
+	d.Set("security_group_id", cluster.SecurityGroup)
+	d.Set("hsm_type", cluster.HsmType)
+	d.Set("backup_identifier", cluster.SourceBackupId)
+	d.Set("cluster_certificates", readCloudHsm2ClusterCertificates(cluster))
+
+	var subnets []string
+	for _, sn := range cluster.SubnetMapping {
+		subnets = append(subnets, *sn)
+	}
+	if err := d.Set("subnet_ids", subnets); err != nil {
+		return fmt.Errorf("error setting subnet_ids for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	}
+
+	var hsms []map[string]interface{}
+	for _, h := range cluster.Hsms {
+		hsms = append(hsms, map[string]interface{}{
+			"hsm_id":            aws.StringValue(h.HsmId),
+			"subnet_id":         aws.StringValue(h.SubnetId),
+			"availability_zone": aws.StringValue(h.AvailabilityZone),
+			"eni_id":            aws.StringValue(h.EniId),
+			"eni_ip":            aws.StringValue(h.EniIp),
+			"hsm_state":         aws.StringValue(h.State),
+		})
+	}
+	if err := d.Set("hsms", hsms); err != nil {
+		return fmt.Errorf("error setting hsms for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	}
+
+	tagList, err := conn.ListTags(&cloudhsmv2.ListTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error retrieving tags for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	}
+
+	tags := make(map[string]string, len(tagList.TagList))
+	for _, t := range tagList.TagList {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+)
+
+func dataSourceAwsCloudHsm2Hsm() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudHsm2HsmRead,
+
+		Schema: map[string]*schema.Schema{
+			"hsm_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"eni_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"eni_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hsm_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudHsm2HsmRead(d *schema.ResourceData, meta interface{}) error {
+	clusterId := d.Get("cluster_id").(string)
+
+	cluster, err := describeCloudHsm2Cluster(clusterId, meta)
+	if err != nil {
+		return fmt.Errorf("error reading CloudHSMv2 Cluster (%s): %s", clusterId, err)
+	}
+	if cluster == nil {
+		return fmt.Errorf("CloudHSMv2 Cluster (%s) not found", clusterId)
+	}
+
+	hsmId, hasHsmId := d.GetOk("hsm_id")
+	availabilityZone, hasAz := d.GetOk("availability_zone")
+
+	var hsm *cloudhsmv2.Hsm
+	var matches []*cloudhsmv2.Hsm
+	for _, h := range cluster.Hsms {
+		if hasHsmId && aws.StringValue(h.HsmId) != hsmId.(string) {
+			continue
+		}
+		if hasAz && aws.StringValue(h.AvailabilityZone) != availabilityZone.(string) {
+			continue
+		}
+		matches = append(matches, h)
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("no CloudHSMv2 HSM matched the given criteria")
+	}
+	if len(matches) > 1 {
+		return fmt.Errorf("more than one CloudHSMv2 HSM matched the given criteria")
+	}
+	hsm = matches[0]
+
+	d.SetId(aws.StringValue(hsm.HsmId))
+	d.Set("hsm_id", hsm.HsmId)
+	d.Set("cluster_id", hsm.ClusterId)
+	d.Set("subnet_id", hsm.SubnetId)
+	d.Set("availability_zone", hsm.AvailabilityZone)
+	d.Set("eni_id", hsm.EniId)
+	d.Set("eni_ip", hsm.EniIp)
+	d.Set("hsm_state", hsm.State)
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func resourceAwsCloudHsm2Backup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudHsm2BackupCreate,
+		Read:   resourceAwsCloudHsm2BackupRead,
+		Update: resourceAwsCloudHsm2BackupUpdate,
+		Delete: resourceAwsCloudHsm2BackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudHsm2BackupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"destination_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"never_expires": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"backup_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"backup_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsCloudHsm2BackupImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("backup_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func describeCloudHsm2Backup(backupId string, meta interface{}) (*cloudhsmv2.Backup, error) {
+	conn := meta.(*AWSClient).cloudhsmv2conn
+
+	filters := []*string{&backupId}
+	result := int64(1)
+	out, err := conn.DescribeBackups(&cloudhsmv2.DescribeBackupsInput{
+		Filters: map[string][]*string{
+			"backupIds": filters,
+		},
+		MaxResults: &result,
+	})
+	if err != nil {
+		log.Printf("[WARN] Error on retrieving CloudHSMv2 Backup (%s) when waiting: %s", backupId, err)
+		return nil, err
+	}
+
+	var backup *cloudhsmv2.Backup
+
+	for _, b := range out.Backups {
+		if *b.BackupId == backupId {
+			backup = b
+		}
+	}
+	return backup, nil
+}
+
+func resourceAwsCloudHsm2BackupRefreshFunc(
+	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := describeCloudHsm2Backup(d.Id(), meta)
+
+		if backup == nil {
+			return 42, "destroyed", nil
+		}
+
+		if backup.BackupState != nil {
+			log.Printf("[DEBUG] CloudHSMv2 Backup status (%s): %s", d.Id(), *backup.BackupState)
+		}
+
+		return backup, *backup.BackupState, err
+	}
+}
+
+func resourceAwsCloudHsm2BackupCreate(d *schema.ResourceData, meta interface{}) error {
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+
+	sourceBackupId := d.Get("source_backup_id").(string)
+	if len(sourceBackupId) == 0 {
+		return fmt.Errorf("[WARN] CloudHSMv2 Backup requires a source_backup_id to copy or restore from")
+	}
+
+	var backupId string
+
+	if destinationRegion := d.Get("destination_region").(string); len(destinationRegion) > 0 {
+		input := &cloudhsmv2.CopyBackupToRegionInput{
+			BackupId:          aws.String(sourceBackupId),
+			DestinationRegion: aws.String(destinationRegion),
+		}
+
+		log.Printf("[DEBUG] CloudHSMv2 Backup copy to region %s", input)
+
+		var output *cloudhsmv2.CopyBackupToRegionOutput
+		err := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
+			var err error
+			output, err = cloudhsm2.CopyBackupToRegion(input)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		backupId = aws.StringValue(output.DestinationBackup.BackupId)
+	} else {
+		input := &cloudhsmv2.RestoreBackupInput{
+			BackupId: aws.String(sourceBackupId),
+		}
+
+		log.Printf("[DEBUG] CloudHSMv2 Backup restore %s", input)
+
+		var output *cloudhsmv2.RestoreBackupOutput
+		err := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
+			var err error
+			output, err = cloudhsm2.RestoreBackup(input)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		backupId = aws.StringValue(output.Backup.BackupId)
+	}
+
+	d.SetId(backupId)
+	log.Printf("[INFO] CloudHSMv2 Backup ID: %s", d.Id())
+	log.Println("[INFO] Waiting for CloudHSMv2 Backup to be ready")
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{cloudhsmv2.BackupStateCreateInProgress, cloudhsmv2.BackupStatePendingDeletion},
+		Target:     []string{cloudhsmv2.BackupStateReady},
+		Refresh:    resourceAwsCloudHsm2BackupRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("[WARN] Error waiting for CloudHSMv2 Backup state to be \"READY\": %s", err)
+	}
+
+	if d.Get("never_expires").(bool) {
+		if err := resourceAwsCloudHsm2BackupSetNeverExpires(cloudhsm2, d); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsCloudHsmV2(cloudhsm2, d, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudHsm2BackupRead(d, meta)
+}
+
+func resourceAwsCloudHsm2BackupSetNeverExpires(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData) error {
+	_, err := conn.ModifyBackupAttributes(&cloudhsmv2.ModifyBackupAttributesInput{
+		BackupId:     aws.String(d.Id()),
+		NeverExpires: aws.Bool(d.Get("never_expires").(bool)),
+	})
+	return err
+}
+
+func resourceAwsCloudHsm2BackupRead(d *schema.ResourceData, meta interface{}) error {
+	backup, err := describeCloudHsm2Backup(d.Id(), meta)
+
+	if backup == nil {
+		log.Printf("[WARN] CloudHSMv2 Backup (%s) not found", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	log.Printf("[INFO] Reading CloudHSMv2 Backup Information: %s", d.Id())
+
+	d.Set("backup_id", backup.BackupId)
+	d.Set("backup_state", backup.BackupState)
+	d.Set("cluster_id", backup.ClusterId)
+	d.Set("source_backup_id", backup.SourceBackup)
+	d.Set("source_region", backup.SourceRegion)
+	d.Set("never_expires", backup.NeverExpires)
+	if backup.CreateTimestamp != nil {
+		d.Set("create_timestamp", backup.CreateTimestamp.Format(time.RFC3339))
+	}
+
+	conn := meta.(*AWSClient).cloudhsmv2conn
+	tagList, err := conn.ListTags(&cloudhsmv2.ListTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("[WARN] Error retrieving tags for CloudHSMv2 Backup (%s): %s", d.Id(), err)
+	}
+
+	tags := make(map[string]string, len(tagList.TagList))
+	for _, t := range tagList.TagList {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceAwsCloudHsm2BackupUpdate(d *schema.ResourceData, meta interface{}) error {
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+
+	if d.HasChange("never_expires") {
+		if err := resourceAwsCloudHsm2BackupSetNeverExpires(cloudhsm2, d); err != nil {
+			return err
+		}
+	}
+
+	if err := setTagsCloudHsmV2(cloudhsm2, d, d.Id()); err != nil {
+		return err
+	}
+
+	return resourceAwsCloudHsm2BackupRead(d, meta)
+}
+
+func resourceAwsCloudHsm2BackupDelete(d *schema.ResourceData, meta interface{}) error {
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+
+	log.Printf("[DEBUG] CloudHSMv2 Backup delete %s", d.Id())
+	err := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
+		_, err := cloudhsm2.DeleteBackup(&cloudhsmv2.DeleteBackupInput{
+			BackupId: aws.String(d.Id()),
+		})
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+	log.Println("[INFO] Waiting for CloudHSMv2 Backup to enter PENDING_DELETION")
+
+	// DeleteBackup only soft-deletes: the backup stays visible in PENDING_DELETION for
+	// up to 7 days before AWS actually removes it, so "destroyed" isn't a realistic
+	// target for this resource's Delete timeout. Treat the READY -> PENDING_DELETION
+	// transition as the terminal state instead.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{cloudhsmv2.BackupStateCreateInProgress, cloudhsmv2.BackupStateReady},
+		Target:     []string{cloudhsmv2.BackupStatePendingDeletion, "destroyed"},
+		Refresh:    resourceAwsCloudHsm2BackupRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("[WARN] Error waiting for CloudHSMv2 Backup state to be \"PENDING_DELETION\": %s", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -178,17 +179,10 @@ func resourceAwsCloudHsm2ClusterCreate(d *schema.ResourceData, meta interface{})
 
 	var output *cloudhsmv2.CreateClusterOutput
 
-	err := resource.Retry(180*time.Second, func() *resource.RetryError {
+	err := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
 		var err error
 		output, err = cloudhsm2.CreateCluster(input)
-		if err != nil {
-			if isAWSErr(err, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-				log.Printf("[DEBUG] CloudHSMv2 Cluster re-try creating %s", input)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+		return err
 	})
 
 	if err != nil {
@@ -258,6 +252,20 @@ func resourceAwsCloudHsm2ClusterRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("[DEBUG] Error saving Subnet IDs to state for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
+	conn := meta.(*AWSClient).cloudhsmv2conn
+	tagList, err := conn.ListTags(&cloudhsmv2.ListTagsInput{
+		ResourceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] Error retrieving tags for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	}
+
+	tags := make(map[string]string, len(tagList.TagList))
+	for _, t := range tagList.TagList {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	d.Set("tags", tags)
+
 	return nil
 }
 
@@ -276,19 +284,12 @@ func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{})
 
 	var output *cloudhsmv2.DeleteClusterOutput
 	log.Printf("[DEBUG] CloudHSMv2 Delete cluster: %s", d.Id())
-	err := resource.Retry(180*time.Second, func() *resource.RetryError {
+	err := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
 		var err error
 		output, err = cloudhsm2.DeleteCluster(&cloudhsmv2.DeleteClusterInput{
 			ClusterId: aws.String(d.Id()),
 		})
-		if err != nil {
-			if isAWSErr(err, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-				log.Printf("[DEBUG] CloudHSMv2 Cluster re-try deleting %s", d.Id())
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+		return err
 	})
 
 	if err != nil {
@@ -315,6 +316,14 @@ func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{})
 }
 
 func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData) error {
+	return setTagsCloudHsmV2(conn, d, d.Id())
+}
+
+// setTagsCloudHsmV2 reconciles the "tags" diff on d against resourceId using the
+// CloudHSMv2 Tag/UntagResource APIs. It is shared by the cluster and backup
+// resources, which tag through the same ResourceId-keyed API. The HSM resource has
+// no tags attribute and does not call it.
+func setTagsCloudHsmV2(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData, resourceId string) error {
 	if d.HasChange("tags") {
 		oraw, nraw := d.GetChange("tags")
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
@@ -322,12 +331,12 @@ func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceD
 		if len(remove) > 0 {
 			log.Printf("[DEBUG] Removing tags: %#v", remove)
 			keys := make([]*string, 0, len(remove))
-			for k, _ := range remove {
+			for k := range remove {
 				keys = append(keys, aws.String(k))
 			}
 
 			_, err := conn.UntagResource(&cloudhsmv2.UntagResourceInput{
-				ResourceId: aws.String(d.Id()),
+				ResourceId: aws.String(resourceId),
 				TagKeyList: keys,
 			})
 			if err != nil {
@@ -344,7 +353,7 @@ func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceD
 				})
 			}
 			_, err := conn.TagResource(&cloudhsmv2.TagResourceInput{
-				ResourceId: aws.String(d.Id()),
+				ResourceId: aws.String(resourceId),
 				TagList:    tagList,
 			})
 			if err != nil {
@@ -356,12 +365,39 @@ func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceD
 	return nil
 }
 
+// cloudHsm2TagsMatch reports whether tagList contains every key/value pair in want.
+// It's used by the CloudHSMv2 data sources to filter on tags client-side, since
+// DescribeBackups/DescribeClusters don't accept a tag filter.
+func cloudHsm2TagsMatch(tagList []*cloudhsmv2.Tag, want map[string]interface{}) bool {
+	have := make(map[string]string, len(tagList))
+	for _, t := range tagList {
+		have[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	for k, v := range want {
+		if have[k] != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cloudHsm2HsmTypeFormat matches the "<family>.<size>" shape of every hsm_type AWS
+// has shipped to date (e.g. "hsm1.medium"). It intentionally doesn't enumerate known
+// types: CreateCluster is the source of truth for which types are
+// actually supported, and will reject an unsupported-but-well-formed value at apply
+// time. Keeping this check permissive means a new type AWS ships doesn't need a
+// provider release before it can be used.
+var cloudHsm2HsmTypeFormat = regexp.MustCompile(`^[a-z0-9]+\.[a-z0-9]+$`)
+
 func validateCloudHsm2HsmType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
-	hsmType := "hsm1.medium"
-	if value != hsmType {
-		errors = append(errors, fmt.Errorf("there is only %s HSM type available", hsmType))
+
+	if !cloudHsm2HsmTypeFormat.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be of the form \"<family>.<size>\" (e.g. %q), got %q", k, "hsm1.medium", value))
 	}
+
 	return
 }
 
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func resourceAwsCloudHsm2ClusterInitialization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudHsm2ClusterInitializationCreate,
+		Read:   resourceAwsCloudHsm2ClusterInitializationRead,
+		Delete: resourceAwsCloudHsm2ClusterInitializationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudHsm2ClusterInitializationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"signed_cert": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"trust_anchor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cluster_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_hardware_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hsm_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"manufacturer_hardware_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudHsm2ClusterInitializationImport(
+	d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("cluster_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsCloudHsm2ClusterInitializationCreate(d *schema.ResourceData, meta interface{}) error {
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+
+	clusterId := d.Get("cluster_id").(string)
+
+	input := &cloudhsmv2.InitializeClusterInput{
+		ClusterId:   aws.String(clusterId),
+		SignedCert:  aws.String(d.Get("signed_cert").(string)),
+		TrustAnchor: aws.String(d.Get("trust_anchor").(string)),
+	}
+
+	log.Printf("[DEBUG] CloudHSMv2 Cluster initialize %s", input)
+
+	err := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
+		_, err := cloudhsm2.InitializeCluster(input)
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(clusterId)
+	log.Println("[INFO] Waiting for CloudHSMv2 Cluster to be initialized")
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{cloudhsmv2.ClusterStateInitializeInProgress},
+		Target:     []string{cloudhsmv2.ClusterStateInitialized, cloudhsmv2.ClusterStateActive},
+		Refresh:    resourceAwsCloudHsm2ClusterRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	// Wait, catching any errors
+	_, errWait := stateConf.WaitForState()
+	if errWait != nil {
+		return fmt.Errorf("[WARN] Error waiting for CloudHSMv2 Cluster state to be \"INITIALIZED\": %s", errWait)
+	}
+
+	return resourceAwsCloudHsm2ClusterInitializationRead(d, meta)
+}
+
+func resourceAwsCloudHsm2ClusterInitializationRead(d *schema.ResourceData, meta interface{}) error {
+	cluster, err := describeCloudHsm2Cluster(d.Id(), meta)
+
+	if cluster == nil {
+		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found", d.Id())
+		d.SetId("")
+		return err
+	}
+
+	log.Printf("[INFO] Reading CloudHSMv2 Cluster Initialization: %s", d.Id())
+
+	d.Set("cluster_id", cluster.ClusterId)
+	d.Set("cluster_state", cluster.State)
+
+	if cluster.Certificates != nil {
+		d.Set("cluster_certificate", cluster.Certificates.ClusterCertificate)
+		d.Set("aws_hardware_certificate", cluster.Certificates.AwsHardwareCertificate)
+		d.Set("hsm_certificate", cluster.Certificates.HsmCertificate)
+		d.Set("manufacturer_hardware_certificate", cluster.Certificates.ManufacturerHardwareCertificate)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudHsm2ClusterInitializationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] CloudHSMv2 Cluster Initialization (%s) cannot be reversed; removing from state only", d.Id())
+	d.SetId("")
+	return nil
+}
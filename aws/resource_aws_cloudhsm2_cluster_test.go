@@ -0,0 +1,30 @@
+package aws
+
+import "testing"
+
+func TestValidateCloudHsm2HsmType(t *testing.T) {
+	validTypes := []string{
+		"hsm1.medium",
+		"hsm2m.medium",
+		"hsm2m.xlarge",
+	}
+	for _, v := range validTypes {
+		if _, errors := validateCloudHsm2HsmType(v, "hsm_type"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid hsm_type: %v", v, errors)
+		}
+	}
+
+	invalidTypes := []string{
+		"",
+		"hsm1",
+		"hsm1.",
+		".medium",
+		"hsm1_medium",
+		"HSM1.MEDIUM",
+	}
+	for _, v := range invalidTypes {
+		if _, errors := validateCloudHsm2HsmType(v, "hsm_type"); len(errors) == 0 {
+			t.Fatalf("%q should not be a valid hsm_type", v)
+		}
+	}
+}
@@ -21,6 +21,7 @@ func resourceAwsCloudHsm2Hsm() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsCloudHsm2HsmImport,
 		},
+		CustomizeDiff: resourceAwsCloudHsm2HsmCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
@@ -69,6 +70,18 @@ func resourceAwsCloudHsm2Hsm() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"auto_replace_degraded": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"min_active_hsms": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
 		},
 	}
 }
@@ -80,10 +93,12 @@ func resourceAwsCloudHsm2HsmImport(
 }
 
 func describeHsm(d *schema.ResourceData, meta interface{}) (*cloudhsmv2.Hsm, error) {
+	return describeHsmById(d.Get("cluster_id").(string), d.Id(), meta)
+}
+
+func describeHsmById(clusterId, hsmId string, meta interface{}) (*cloudhsmv2.Hsm, error) {
 	conn := meta.(*AWSClient).cloudhsmv2conn
 
-	clusterId := d.Get("cluster_id").(string)
-	hsmId := d.Id()
 	filters := []*string{&clusterId}
 	result := int64(1)
 	out, err := conn.DescribeClusters(&cloudhsmv2.DescribeClustersInput{
@@ -93,7 +108,7 @@ func describeHsm(d *schema.ResourceData, meta interface{}) (*cloudhsmv2.Hsm, err
 		MaxResults: &result,
 	})
 	if err != nil {
-		log.Printf("[WARN] Error on retrieving CloudHSMv2 Cluster (%s) when waiting: %s", d.Id(), err)
+		log.Printf("[WARN] Error on retrieving CloudHSMv2 Cluster (%s) when waiting: %s", hsmId, err)
 		return nil, err
 	}
 
@@ -134,20 +149,34 @@ func resourceAwsCloudHsm2HsmRefreshFunc(
 	}
 }
 
-func resourceAwsCloudHsm2HsmCreate(d *schema.ResourceData, meta interface{}) error {
-	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+func resourceAwsCloudHsm2HsmRefreshFuncById(clusterId, hsmId string, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		hsm, err := describeHsmById(clusterId, hsmId, meta)
 
-	clusterId := d.Get("cluster_id").(string)
+		if hsm == nil {
+			return 42, "destroyed", nil
+		}
 
-	cluster, err := describeCloudHsm2Cluster(clusterId, meta)
+		if hsm.State != nil {
+			log.Printf("[DEBUG] CloudHSMv2 HSM status (%s): %s", hsmId, *hsm.State)
+		}
 
-	if cluster == nil {
-		log.Printf("[WARN] Error on retrieving CloudHSMv2 Cluster: %s %s", clusterId, err)
-		return err
+		return hsm, *hsm.State, err
 	}
+}
+
+func resourceAwsCloudHsm2HsmCreate(d *schema.ResourceData, meta interface{}) error {
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
 
+	clusterId := d.Get("cluster_id").(string)
 	availabilityZone := d.Get("availability_zone").(string)
 	if len(availabilityZone) == 0 {
+		cluster, err := describeCloudHsm2Cluster(clusterId, meta)
+		if cluster == nil {
+			log.Printf("[WARN] Error on retrieving CloudHSMv2 Cluster: %s %s", clusterId, err)
+			return err
+		}
+
 		subnetId := d.Get("subnet_id").(string)
 		for az, sn := range cluster.SubnetMapping {
 			if *sn == subnetId {
@@ -156,12 +185,25 @@ func resourceAwsCloudHsm2HsmCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	hsmId, err := createCloudHsm2Hsm(cloudhsm2, clusterId, availabilityZone, d.Get("ip_address").(string), d, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(hsmId)
+
+	return resourceAwsCloudHsm2HsmRead(d, meta)
+}
+
+// createCloudHsm2Hsm issues CreateHsm for the given cluster/AZ/IP and blocks until the
+// new HSM reaches ACTIVE. It is shared by Create and the DEGRADED auto-replacement path
+// in Update.
+func createCloudHsm2Hsm(cloudhsm2 *cloudhsmv2.CloudHSMV2, clusterId, availabilityZone, ipAddress string, d *schema.ResourceData, meta interface{}) (string, error) {
 	input := &cloudhsmv2.CreateHsmInput{
 		ClusterId:        aws.String(clusterId),
 		AvailabilityZone: aws.String(availabilityZone),
 	}
 
-	ipAddress := d.Get("ip_address").(string)
 	if len(ipAddress) != 0 {
 		input.IpAddress = aws.String(ipAddress)
 	}
@@ -170,43 +212,34 @@ func resourceAwsCloudHsm2HsmCreate(d *schema.ResourceData, meta interface{}) err
 
 	var output *cloudhsmv2.CreateHsmOutput
 
-	errRetry := resource.Retry(180*time.Second, func() *resource.RetryError {
+	errRetry := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
 		var err error
 		output, err = cloudhsm2.CreateHsm(input)
-		if err != nil {
-			if isAWSErr(err, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-				log.Printf("[DEBUG] CloudHSMv2 HSM re-try creating %s", input)
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+		return err
 	})
 
 	if errRetry != nil {
-		return errRetry
+		return "", errRetry
 	}
 
-	d.SetId(aws.StringValue(output.Hsm.HsmId))
-	log.Printf("[INFO] CloudHSMv2 HSM Id: %s", d.Id())
+	hsmId := aws.StringValue(output.Hsm.HsmId)
+	log.Printf("[INFO] CloudHSMv2 HSM Id: %s", hsmId)
 	log.Println("[INFO] Waiting for CloudHSMv2 HSM to be available")
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{cloudhsmv2.HsmStateCreateInProgress, "destroyed"},
 		Target:     []string{cloudhsmv2.HsmStateActive},
-		Refresh:    resourceAwsCloudHsm2HsmRefreshFunc(d, meta),
+		Refresh:    resourceAwsCloudHsm2HsmRefreshFuncById(clusterId, hsmId, meta),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 30 * time.Second,
 		Delay:      30 * time.Second,
 	}
 
-	// Wait, catching any errors
-	_, errWait := stateConf.WaitForState()
-	if errWait != nil {
-		return fmt.Errorf("[WARN] Error waiting for CloudHSMv2 HSM state to be \"ACTIVE\": %s", errWait)
+	if _, errWait := stateConf.WaitForState(); errWait != nil {
+		return hsmId, fmt.Errorf("[WARN] Error waiting for CloudHSMv2 HSM state to be \"ACTIVE\": %s", errWait)
 	}
 
-	return resourceAwsCloudHsm2HsmRead(d, meta)
+	return hsmId, nil
 }
 
 func resourceAwsCloudHsm2HsmRead(d *schema.ResourceData, meta interface{}) error {
@@ -232,8 +265,126 @@ func resourceAwsCloudHsm2HsmRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// cloudHsm2ShouldForceHsmReplacement reports whether a DEGRADED HSM with
+// auto_replace_degraded enabled should be forced through a replacement diff.
+func cloudHsm2ShouldForceHsmReplacement(autoReplaceDegraded bool, hsmState string) bool {
+	return autoReplaceDegraded && hsmState == cloudhsmv2.HsmStateDegraded
+}
+
+// resourceAwsCloudHsm2HsmCustomizeDiff forces a diff when refresh observes the HSM
+// has gone DEGRADED and auto_replace_degraded is enabled. Read only ever reports
+// state - it must never mutate infrastructure - so the actual replacement happens
+// in Update once the user approves this diff.
+func resourceAwsCloudHsm2HsmCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !cloudHsm2ShouldForceHsmReplacement(d.Get("auto_replace_degraded").(bool), d.Get("hsm_state").(string)) {
+		return nil
+	}
+
+	// ip_address is ForceNew, so marking it computed would plan a destroy/create
+	// instead of Update; it keeps its current value so the replacement can reuse it.
+	for _, attr := range []string{"hsm_state", "hsm_id", "hsm_eni_id", "subnet_id"} {
+		if err := d.SetNewComputed(attr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cloudHsm2ActiveHsmCount counts the HSMs in the ACTIVE state.
+func cloudHsm2ActiveHsmCount(hsms []*cloudhsmv2.Hsm) int {
+	activeCount := 0
+	for _, h := range hsms {
+		if aws.StringValue(h.State) == cloudhsmv2.HsmStateActive {
+			activeCount++
+		}
+	}
+	return activeCount
+}
+
+// cloudHsm2CanReplaceDegraded reports whether the cluster has enough ACTIVE HSMs to
+// absorb deleting a DEGRADED one without dropping below minActiveHsms.
+func cloudHsm2CanReplaceDegraded(activeCount, minActiveHsms int) bool {
+	return activeCount >= minActiveHsms
+}
+
+// resourceAwsCloudHsm2HsmReplaceDegraded deletes a DEGRADED HSM and creates its
+// replacement in the same availability_zone (reusing ip_address where possible),
+// guarding on min_active_hsms so a replacement never drops the cluster below the
+// quorum the caller configured. It updates d's Id to the replacement HSM and
+// returns the replacement's description.
+// It holds awsMutexKV on clusterId while it runs: Terraform refreshes/updates sibling
+// aws_cloudhsm_v2_hsm resources in the same cluster concurrently, and without a
+// lock two HSMs going DEGRADED at once could both observe activeCount above
+// min_active_hsms and delete before either replacement lands, dropping the
+// cluster below its configured minimum.
+func resourceAwsCloudHsm2HsmReplaceDegraded(d *schema.ResourceData, meta interface{}) (*cloudhsmv2.Hsm, error) {
+	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
+	clusterId := d.Get("cluster_id").(string)
+	minActiveHsms := d.Get("min_active_hsms").(int)
+
+	awsMutexKV.Lock(clusterId)
+	defer awsMutexKV.Unlock(clusterId)
+
+	cluster, err := describeCloudHsm2Cluster(clusterId, meta)
+	if cluster == nil {
+		return nil, fmt.Errorf("[WARN] Error on retrieving CloudHSMv2 Cluster: %s %s", clusterId, err)
+	}
+
+	activeCount := cloudHsm2ActiveHsmCount(cluster.Hsms)
+
+	if !cloudHsm2CanReplaceDegraded(activeCount, minActiveHsms) {
+		return nil, fmt.Errorf("[WARN] CloudHSMv2 HSM (%s) is DEGRADED but only %d of %d required active HSMs remain in cluster %s; refusing to replace", d.Id(), activeCount, minActiveHsms, clusterId)
+	}
+
+	degradedId := d.Id()
+	availabilityZone := d.Get("availability_zone").(string)
+	ipAddress := d.Get("ip_address").(string)
+
+	log.Printf("[INFO] CloudHSMv2 HSM (%s) is DEGRADED, replacing in %s", degradedId, availabilityZone)
+
+	err = retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
+		_, err := cloudhsm2.DeleteHsm(&cloudhsmv2.DeleteHsmInput{
+			ClusterId: aws.String(clusterId),
+			HsmId:     aws.String(degradedId),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deleteConf := &resource.StateChangeConf{
+		Pending:    []string{cloudhsmv2.HsmStateDegraded, cloudhsmv2.HsmStateDeleteInProgress},
+		Target:     []string{"destroyed"},
+		Refresh:    resourceAwsCloudHsm2HsmRefreshFuncById(clusterId, degradedId, meta),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+	if _, err := deleteConf.WaitForState(); err != nil {
+		return nil, fmt.Errorf("[WARN] Error waiting for degraded CloudHSMv2 HSM (%s) to be deleted: %s", degradedId, err)
+	}
+
+	newHsmId, err := createCloudHsm2Hsm(cloudhsm2, clusterId, availabilityZone, ipAddress, d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(newHsmId)
+
+	return describeHsmById(clusterId, newHsmId, meta)
+}
+
 func resourceAwsCloudHsm2HsmUpdate(d *schema.ResourceData, meta interface{}) error {
-	//nothing to update in here
+	// CustomizeDiff marks hsm_state as computed, so the refreshed state is the old value.
+	oldHsmState, _ := d.GetChange("hsm_state")
+	if cloudHsm2ShouldForceHsmReplacement(d.Get("auto_replace_degraded").(bool), oldHsmState.(string)) {
+		if _, err := resourceAwsCloudHsm2HsmReplaceDegraded(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsCloudHsm2HsmRead(d, meta)
 }
 
@@ -245,20 +396,13 @@ func resourceAwsCloudHsm2HsmDelete(d *schema.ResourceData, meta interface{}) err
 
 	var output *cloudhsmv2.DeleteHsmOutput
 
-	errRetry := resource.Retry(180*time.Second, func() *resource.RetryError {
+	errRetry := retryCloudHsmV2(defaultCloudHsm2CreateRetryTimeout(), func() error {
 		var err error
 		output, err = cloudhsm2.DeleteHsm(&cloudhsmv2.DeleteHsmInput{
 			ClusterId: aws.String(clusterId),
 			HsmId:     aws.String(d.Id()),
 		})
-		if err != nil {
-			if isAWSErr(err, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-				log.Printf("[DEBUG] CloudHSMv2 HSM re-try deleting %s", d.Id())
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+		return err
 	})
 
 	if errRetry != nil {
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+)
+
+func TestCloudHsm2ActiveHsmCount(t *testing.T) {
+	hsms := []*cloudhsmv2.Hsm{
+		{State: aws.String(cloudhsmv2.HsmStateActive)},
+		{State: aws.String(cloudhsmv2.HsmStateActive)},
+		{State: aws.String(cloudhsmv2.HsmStateDegraded)},
+		{State: aws.String(cloudhsmv2.HsmStateCreateInProgress)},
+	}
+
+	if got := cloudHsm2ActiveHsmCount(hsms); got != 2 {
+		t.Errorf("expected 2 active HSMs, got %d", got)
+	}
+
+	if got := cloudHsm2ActiveHsmCount(nil); got != 0 {
+		t.Errorf("expected 0 active HSMs for an empty cluster, got %d", got)
+	}
+}
+
+func TestCloudHsm2CanReplaceDegraded(t *testing.T) {
+	cases := []struct {
+		activeCount   int
+		minActiveHsms int
+		want          bool
+	}{
+		{activeCount: 3, minActiveHsms: 1, want: true},
+		{activeCount: 1, minActiveHsms: 1, want: true},
+		{activeCount: 0, minActiveHsms: 1, want: false},
+		{activeCount: 2, minActiveHsms: 3, want: false},
+	}
+
+	for _, c := range cases {
+		if got := cloudHsm2CanReplaceDegraded(c.activeCount, c.minActiveHsms); got != c.want {
+			t.Errorf("cloudHsm2CanReplaceDegraded(%d, %d) = %v, want %v", c.activeCount, c.minActiveHsms, got, c.want)
+		}
+	}
+}
+
+func TestCloudHsm2ShouldForceHsmReplacement(t *testing.T) {
+	cases := []struct {
+		autoReplaceDegraded bool
+		hsmState            string
+		want                bool
+	}{
+		{autoReplaceDegraded: true, hsmState: cloudhsmv2.HsmStateDegraded, want: true},
+		{autoReplaceDegraded: false, hsmState: cloudhsmv2.HsmStateDegraded, want: false},
+		{autoReplaceDegraded: true, hsmState: cloudhsmv2.HsmStateActive, want: false},
+		{autoReplaceDegraded: false, hsmState: cloudhsmv2.HsmStateActive, want: false},
+	}
+
+	for _, c := range cases {
+		if got := cloudHsm2ShouldForceHsmReplacement(c.autoReplaceDegraded, c.hsmState); got != c.want {
+			t.Errorf("cloudHsm2ShouldForceHsmReplacement(%v, %q) = %v, want %v", c.autoReplaceDegraded, c.hsmState, got, c.want)
+		}
+	}
+}
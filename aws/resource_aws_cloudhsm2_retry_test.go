@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+)
+
+func TestIsRetryableCloudHsmV2Error(t *testing.T) {
+	retryable := []error{
+		awserr.New(cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "internal failure", nil),
+		awserr.New(cloudhsmv2.ErrCodeCloudHsmServiceException, "the resource is in a transitional state", nil),
+		awserr.New("ThrottlingException", "rate exceeded", nil),
+		awserr.New("TooManyRequestsException", "rate exceeded", nil),
+		awserr.New("NetworkInterfaceInUse", "eni in use", nil),
+		awserr.New("InvalidNetworkInterfaceID.NotFound", "eni not found", nil),
+	}
+	for _, err := range retryable {
+		if !isRetryableCloudHsmV2Error(err) {
+			t.Errorf("expected %v to be retryable", err)
+		}
+	}
+
+	nonRetryable := []error{
+		awserr.New(cloudhsmv2.ErrCodeCloudHsmInvalidRequestException, "bad request", nil),
+		awserr.New(cloudhsmv2.ErrCodeCloudHsmServiceException, "some other service error", nil),
+		errors.New("some unrelated error"),
+	}
+	for _, err := range nonRetryable {
+		if isRetryableCloudHsmV2Error(err) {
+			t.Errorf("expected %v to not be retryable", err)
+		}
+	}
+}
+
+func TestDefaultCloudHsm2CreateRetryTimeout(t *testing.T) {
+	const envVar = "AWS_CLOUDHSM_V2_CREATE_RETRY_TIMEOUT"
+	orig, wasSet := os.LookupEnv(envVar)
+	defer func() {
+		if wasSet {
+			os.Setenv(envVar, orig)
+		} else {
+			os.Unsetenv(envVar)
+		}
+	}()
+
+	os.Unsetenv(envVar)
+	if got := defaultCloudHsm2CreateRetryTimeout(); got != defaultCloudHsm2CreateRetryTimeoutDefault {
+		t.Errorf("expected default timeout %s when unset, got %s", defaultCloudHsm2CreateRetryTimeoutDefault, got)
+	}
+
+	os.Setenv(envVar, "invalid-duration")
+	if got := defaultCloudHsm2CreateRetryTimeout(); got != defaultCloudHsm2CreateRetryTimeoutDefault {
+		t.Errorf("expected default timeout %s for invalid value, got %s", defaultCloudHsm2CreateRetryTimeoutDefault, got)
+	}
+
+	os.Setenv(envVar, "5m")
+	if got := defaultCloudHsm2CreateRetryTimeout(); got != 5*time.Minute {
+		t.Errorf("expected timeout of 5m, got %s", got)
+	}
+}